@@ -38,6 +38,30 @@ func SplitDataset(inputs [][][]float64, targets [][][]float64, trainFrac float64
 	return
 }
 
+// SplitCorpus shuffles sentences deterministically with the given seed and
+// splits them into train/validation sets, with valFraction of them (e.g. 0.1
+// for 10%) going to validation. The input slice is not modified.
+func SplitCorpus(sentences []string, valFraction float64, seed int64) (train, val []string) {
+	if !(valFraction >= 0 && valFraction <= 1) {
+		panic("valFraction must be in [0, 1]")
+	}
+	n := len(sentences)
+	valSize := int(valFraction * float64(n))
+
+	perm := rand.New(rand.NewSource(seed)).Perm(n)
+	train = make([]string, 0, n-valSize)
+	val = make([]string, 0, valSize)
+
+	for i, p := range perm {
+		if i < valSize {
+			val = append(val, sentences[p])
+		} else {
+			train = append(train, sentences[p])
+		}
+	}
+	return
+}
+
 // Cleaner processes the given data array by cleaning specified columns.
 // For columns in nameCols, automatically detects and removes the common prefix from cube names (e.g., "[ARC]-OC-gen1-v0-POD_192.168.0.227_10023_head" to "head").
 // For columns in paramCols, extracts the value from key:value pairs (e.g., "motor_target_velocity:0" to "0").
@@ -0,0 +1,51 @@
+package paragon
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSplitCorpus(t *testing.T) {
+	sentences := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+	orig := append([]string(nil), sentences...)
+	const valFraction = 0.3
+
+	train, val := SplitCorpus(sentences, valFraction, 42)
+
+	if want := int(valFraction * float64(len(sentences))); len(val) != want {
+		t.Fatalf("len(val) = %d, want %d", len(val), want)
+	}
+	if len(train)+len(val) != len(sentences) {
+		t.Fatalf("len(train)+len(val) = %d, want %d", len(train)+len(val), len(sentences))
+	}
+
+	all := append(append([]string(nil), train...), val...)
+	sort.Strings(all)
+	if !reflect.DeepEqual(all, orig) {
+		t.Fatalf("train+val = %v, want a permutation of %v", all, orig)
+	}
+
+	train2, val2 := SplitCorpus(sentences, valFraction, 42)
+	if !reflect.DeepEqual(train, train2) || !reflect.DeepEqual(val, val2) {
+		t.Fatal("same seed produced a different split")
+	}
+
+	if !reflect.DeepEqual(sentences, orig) {
+		t.Fatalf("input modified: got %v, want %v", sentences, orig)
+	}
+}
+
+func TestSplitCorpusRejectsInvalidFraction(t *testing.T) {
+	for _, f := range []float64{-0.1, 1.1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("valFraction %v: expected panic", f)
+				}
+			}()
+			SplitCorpus([]string{"a", "b"}, f, 1)
+		}()
+	}
+}
@@ -0,0 +1,134 @@
+package paragon
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Sampler picks an index from a probability distribution (e.g. a softmax
+// over the vocabulary). Implementations must not modify probs.
+// A nil rng falls back to the global math/rand source.
+type Sampler interface {
+	Sample(probs []float64, rng *rand.Rand) int
+}
+
+// GreedySampler always returns the most probable index.
+type GreedySampler struct{}
+
+func (GreedySampler) Sample(probs []float64, rng *rand.Rand) int {
+	return ArgMax(probs)
+}
+
+// TopKSampler samples from the K most probable indices, renormalized.
+// K <= 0 or K >= len(probs) samples from the full distribution.
+type TopKSampler struct {
+	K int
+}
+
+func (s TopKSampler) Sample(probs []float64, rng *rand.Rand) int {
+	idx := sortedIndices(probs)
+	if s.K > 0 && s.K < len(idx) {
+		idx = idx[:s.K]
+	}
+	return sampleFromIndices(probs, idx, rng)
+}
+
+// TopPSampler (nucleus sampling) samples from the smallest set of most
+// probable indices whose cumulative probability reaches P, renormalized.
+// P <= 0 or P >= 1 samples from the full distribution.
+type TopPSampler struct {
+	P float64
+}
+
+func (s TopPSampler) Sample(probs []float64, rng *rand.Rand) int {
+	idx := sortedIndices(probs)
+	if s.P > 0 && s.P < 1 {
+		cum := 0.0
+		for i, j := range idx {
+			cum += probs[j]
+			if cum >= s.P {
+				idx = idx[:i+1]
+				break
+			}
+		}
+	}
+	return sampleFromIndices(probs, idx, rng)
+}
+
+// MinPSampler discards indices whose probability is below P times the
+// maximum probability, then samples from the rest, renormalized.
+// P <= 0 samples from the full distribution. NaN entries are never drawn
+// and the most probable index is always kept, so P > 1 never empties the
+// candidate set. If every entry is NaN it returns index 0.
+type MinPSampler struct {
+	P float64
+}
+
+func (s MinPSampler) Sample(probs []float64, rng *rand.Rand) int {
+	maxIdx := -1
+	for i, p := range probs {
+		if !math.IsNaN(p) && (maxIdx < 0 || p > probs[maxIdx]) {
+			maxIdx = i
+		}
+	}
+	if maxIdx < 0 {
+		return ArgMax(probs)
+	}
+	threshold := s.P * probs[maxIdx]
+	idx := make([]int, 0, len(probs))
+	for i, p := range probs {
+		if i == maxIdx || p >= threshold {
+			idx = append(idx, i)
+		}
+	}
+	return sampleFromIndices(probs, idx, rng)
+}
+
+// sortedIndices returns the indices of probs ordered by descending probability.
+// Ties keep their original order so results are deterministic.
+func sortedIndices(probs []float64) []int {
+	idx := make([]int, len(probs))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return probs[idx[a]] > probs[idx[b]]
+	})
+	return idx
+}
+
+// sampleFromIndices draws one of idx proportionally to probs[idx[i]].
+// Zero-mass candidates are never drawn unless all candidates carry no mass,
+// in which case it returns the first one. It returns -1 only for empty idx.
+func sampleFromIndices(probs []float64, idx []int, rng *rand.Rand) int {
+	if len(idx) == 0 {
+		return -1
+	}
+	total := 0.0
+	for _, j := range idx {
+		total += probs[j]
+	}
+	if total <= 0 {
+		return idx[0]
+	}
+
+	var r float64
+	if rng != nil {
+		r = rng.Float64() * total
+	} else {
+		r = rand.Float64() * total
+	}
+	last := idx[0]
+	for _, j := range idx {
+		if probs[j] <= 0 {
+			continue
+		}
+		last = j
+		r -= probs[j]
+		if r < 0 {
+			return j
+		}
+	}
+	return last
+}
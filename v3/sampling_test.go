@@ -0,0 +1,113 @@
+package paragon
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestGreedySampler(t *testing.T) {
+	probs := []float64{0.1, 0.2, 0.6, 0.1}
+	if got := (GreedySampler{}).Sample(probs, nil); got != 2 {
+		t.Fatalf("got %d, want 2", got)
+	}
+}
+
+func TestTopKSampler(t *testing.T) {
+	probs := []float64{0.05, 0.4, 0.1, 0.3, 0.15}
+	allowed := map[int]bool{1: true, 3: true}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if got := (TopKSampler{K: 2}).Sample(probs, rng); !allowed[got] {
+			t.Fatalf("draw %d: got %d, outside the top 2", i, got)
+		}
+	}
+}
+
+func TestTopPSampler(t *testing.T) {
+	// Sorted: 1 (0.5), 3 (0.3), 2 (0.15), 0 (0.05). The first prefix whose
+	// mass reaches 0.8 is {1, 3}.
+	probs := []float64{0.05, 0.5, 0.15, 0.3}
+	allowed := map[int]bool{1: true, 3: true}
+	rng := rand.New(rand.NewSource(1))
+	seen := make(map[int]bool)
+	for i := 0; i < 1000; i++ {
+		got := (TopPSampler{P: 0.8}).Sample(probs, rng)
+		if !allowed[got] {
+			t.Fatalf("draw %d: got %d, outside the nucleus", i, got)
+		}
+		seen[got] = true
+	}
+	if len(seen) != len(allowed) {
+		t.Fatalf("sampled %v, want every index in the nucleus", seen)
+	}
+}
+
+func TestMinPSampler(t *testing.T) {
+	// max = 0.5, so P = 0.5 drops everything below 0.25.
+	probs := []float64{0.5, 0.3, 0.2, 0}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if got := (MinPSampler{P: 0.5}).Sample(probs, rng); got != 0 && got != 1 {
+			t.Fatalf("draw %d: got %d, below P*max", i, got)
+		}
+	}
+
+	if got := (MinPSampler{P: 2}).Sample(probs, rng); got != 0 {
+		t.Fatalf("P > 1: got %d, want argmax 0", got)
+	}
+	for i := 0; i < 100; i++ {
+		if got := (MinPSampler{P: 0.5}).Sample([]float64{math.NaN(), 0.5}, rng); got != 1 {
+			t.Fatalf("NaN probs draw %d: got %d, want 1", i, got)
+		}
+	}
+}
+
+func TestSamplersRepeatableWithSeed(t *testing.T) {
+	probs := []float64{0.1, 0.2, 0.3, 0.25, 0.15}
+	samplers := map[string]Sampler{
+		"topk": TopKSampler{K: 4},
+		"topp": TopPSampler{P: 0.9},
+		"minp": MinPSampler{P: 0.3},
+	}
+	for name, s := range samplers {
+		a := rand.New(rand.NewSource(7))
+		b := rand.New(rand.NewSource(7))
+		for i := 0; i < 100; i++ {
+			if x, y := s.Sample(probs, a), s.Sample(probs, b); x != y {
+				t.Fatalf("%s draw %d: %d != %d with the same seed", name, i, x, y)
+			}
+		}
+	}
+}
+
+func TestSamplersEmptyInput(t *testing.T) {
+	samplers := map[string]Sampler{
+		"greedy": GreedySampler{},
+		"topk":   TopKSampler{K: 2},
+		"topp":   TopPSampler{P: 0.9},
+		"minp":   MinPSampler{P: 0.1},
+	}
+	for name, s := range samplers {
+		if got := s.Sample(nil, nil); got != -1 {
+			t.Errorf("%s: got %d, want -1", name, got)
+		}
+	}
+}
+
+func TestSamplersSkipZeroMass(t *testing.T) {
+	probs := []float64{0, 0.7, 0, 0.3, 0}
+	samplers := map[string]Sampler{
+		"topk": TopKSampler{},
+		"topp": TopPSampler{},
+		"minp": MinPSampler{},
+	}
+	rng := rand.New(rand.NewSource(3))
+	for name, s := range samplers {
+		for i := 0; i < 1000; i++ {
+			if got := s.Sample(probs, rng); probs[got] == 0 {
+				t.Fatalf("%s draw %d: chose zero-mass index %d", name, i, got)
+			}
+		}
+	}
+}
@@ -28,6 +28,44 @@ func Softmax(inputs []float64) []float64 {
 	return expInputs
 }
 
+// SoftmaxInPlace computes the softmax of logits, overwriting the slice with
+// the resulting probabilities. It matches Softmax without allocating.
+func SoftmaxInPlace(logits []float64) {
+	if len(logits) == 0 {
+		return
+	}
+	maxVal := logits[0]
+	for _, v := range logits {
+		if v > maxVal {
+			maxVal = v
+		}
+	}
+	expSum := 0.0
+	for i, v := range logits {
+		logits[i] = math.Exp(v - maxVal)
+		expSum += logits[i]
+	}
+	for i := range logits {
+		logits[i] /= expSum
+	}
+}
+
+// SoftmaxRows normalizes each consecutive rowSize-long row of a flat slice
+// in place, e.g. the MaxLength rows of a [MaxLength*VocabSize] output.
+// A trailing partial row is normalized on its own.
+func SoftmaxRows(flat []float64, rowSize int) {
+	if rowSize <= 0 {
+		return
+	}
+	for start := 0; start < len(flat); start += rowSize {
+		end := start + rowSize
+		if end > len(flat) {
+			end = len(flat)
+		}
+		SoftmaxInPlace(flat[start:end])
+	}
+}
+
 // ArgMax returns the index of the maximum value in the slice.
 // If the slice is empty, it returns -1.
 func ArgMax(arr []float64) int {
@@ -0,0 +1,65 @@
+package paragon
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSoftmaxRowsMatchesSoftmax(t *testing.T) {
+	const rowSize = 4
+	flat := []float64{
+		1, 2, 3, 4,
+		-5, 0, 5, 10,
+		0.5, 0.5, 0.5, 0.5,
+		700, 1, 2, // trailing partial row
+	}
+	orig := append([]float64(nil), flat...)
+
+	SoftmaxRows(flat, rowSize)
+
+	for start := 0; start < len(orig); start += rowSize {
+		end := start + rowSize
+		if end > len(orig) {
+			end = len(orig)
+		}
+		want := Softmax(orig[start:end])
+		for i, w := range want {
+			if got := flat[start+i]; got != w {
+				t.Fatalf("index %d: got %v, want %v", start+i, got, w)
+			}
+		}
+	}
+}
+
+func benchLogits(rows, rowSize int) []float64 {
+	rng := rand.New(rand.NewSource(1))
+	flat := make([]float64, rows*rowSize)
+	for i := range flat {
+		flat[i] = rng.NormFloat64()
+	}
+	return flat
+}
+
+func BenchmarkSoftmaxPerSlice(b *testing.B) {
+	const rows, rowSize = 64, 1000
+	flat := benchLogits(rows, rowSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for start := 0; start < len(flat); start += rowSize {
+			_ = Softmax(flat[start : start+rowSize])
+		}
+	}
+}
+
+func BenchmarkSoftmaxRows(b *testing.B) {
+	const rows, rowSize = 64, 1000
+	src := benchLogits(rows, rowSize)
+	flat := make([]float64, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(flat, src)
+		SoftmaxRows(flat, rowSize)
+	}
+}